@@ -0,0 +1,92 @@
+package multipow
+
+import (
+	"encoding/binary"
+	"math/big"
+)
+
+// CompactToBig expands a compact "bits" encoded target - the exponent and
+// mantissa encoding used by Bitcoin-derived chains to pack a 256-bit target
+// into 32 bits - into the big.Int it represents.
+func CompactToBig(bits uint32) *big.Int {
+	mantissa := bits & 0x007fffff
+	isNegative := bits&0x00800000 != 0
+	exponent := uint(bits >> 24)
+
+	var target *big.Int
+	if exponent <= 3 {
+		mantissa >>= 8 * (3 - exponent)
+		target = big.NewInt(int64(mantissa))
+	} else {
+		target = big.NewInt(int64(mantissa))
+		target.Lsh(target, 8*(exponent-3))
+	}
+
+	if isNegative {
+		target.Neg(target)
+	}
+	return target
+}
+
+// BigToCompact compresses target into the compact "bits" encoding, the
+// inverse of CompactToBig. Precision beyond 24 significant bits is lost, the
+// same trade-off the compact encoding always makes.
+func BigToCompact(target *big.Int) uint32 {
+	if target.Sign() == 0 {
+		return 0
+	}
+
+	var mantissa uint32
+	exponent := uint(len(target.Bytes()))
+	if exponent <= 3 {
+		mantissa = uint32(target.Bits()[0])
+		mantissa <<= 8 * (3 - exponent)
+	} else {
+		tn := new(big.Int).Set(target)
+		mantissa = uint32(tn.Rsh(tn, 8*(exponent-3)).Bits()[0])
+	}
+
+	// The high bit of the mantissa is reserved as a sign flag, so if it's
+	// set shift one byte of precision into the exponent instead.
+	if mantissa&0x00800000 != 0 {
+		mantissa >>= 8
+		exponent++
+	}
+
+	bits := uint32(exponent<<24) | mantissa
+	if target.Sign() < 0 {
+		bits |= 0x00800000
+	}
+	return bits
+}
+
+// HashToBig interprets h as a big-endian 256-bit integer, the representation
+// used whenever a hash is compared against a Target.
+func HashToBig(h [32]byte) *big.Int {
+	return new(big.Int).SetBytes(h[:])
+}
+
+// Meets reports whether hash satisfies target, i.e. HashToBig(hash) is
+// strictly below target.
+func Meets(hash [32]byte, target *big.Int) bool {
+	return HashToBig(hash).Cmp(target) < 0
+}
+
+// legacyMeets reports whether hash's leading 8 bytes, read as a big-endian
+// uint64, are below difficulty - the original comparison used throughout
+// this package before Target/Meets threaded a full 256-bit target through.
+// SolveParallel and SolveIndependent still compare against a plain uint64
+// difficulty, so they share this instead of each re-implementing it.
+func legacyMeets(hash [32]byte, difficulty uint64) bool {
+	return binary.BigEndian.Uint64(hash[:8]) < difficulty
+}
+
+// LegacyDifficultyToTarget converts a difficulty expressed in this
+// package's original scheme - a hash qualifies when the big-endian uint64
+// formed by its first 8 bytes is below difficulty - into the equivalent
+// full 256-bit target. Code moving from Miner.Difficulty to Miner.Target
+// can run this once so existing difficulty values keep behaving the same.
+func LegacyDifficultyToTarget(difficulty uint64) *big.Int {
+	target := new(big.Int).SetUint64(difficulty)
+	return target.Lsh(target, 192)
+}
@@ -0,0 +1,79 @@
+package multipow
+
+import (
+	"encoding/binary"
+	"math/big"
+	"testing"
+)
+
+func TestCompactToBigRoundTrip(t *testing.T) {
+	cases := []string{
+		"0",
+		"1",
+		"255",
+		"1000000",
+		"452312848583266388373324160190187140051835877600158453279131187530910662656", // 2^248
+	}
+
+	for _, c := range cases {
+		t.Run(c, func(t *testing.T) {
+			want, ok := new(big.Int).SetString(c, 10)
+			if !ok {
+				t.Fatalf("bad test value %q", c)
+			}
+
+			bits := BigToCompact(want)
+			got := CompactToBig(bits)
+			if got.Cmp(want) != 0 {
+				t.Fatalf("CompactToBig(BigToCompact(%s)) = %s, want %s", want, got, want)
+			}
+		})
+	}
+}
+
+func TestHashToBigAndMeets(t *testing.T) {
+	var hash [32]byte
+	hash[31] = 0x05 // hash, read big-endian, is the small integer 5
+
+	got := HashToBig(hash)
+	if got.Cmp(big.NewInt(5)) != 0 {
+		t.Fatalf("HashToBig = %s, want 5", got)
+	}
+
+	target := big.NewInt(10)
+	if !Meets(hash, target) {
+		t.Fatal("Meets reported false for a hash below target")
+	}
+
+	hash[31] = 0xFF // hash is now 255, above target
+	if Meets(hash, target) {
+		t.Fatal("Meets reported true for a hash above target")
+	}
+}
+
+func TestLegacyDifficultyToTargetMatchesOldComparison(t *testing.T) {
+	difficulty := uint64(0x003FFFFFFFFFFFFF)
+	target := LegacyDifficultyToTarget(difficulty)
+
+	// A hash whose leading 8 bytes equal difficulty-1 qualified under the
+	// old comparison regardless of its remaining bytes; it must still
+	// qualify against the migrated 256-bit target.
+	var hash [32]byte
+	binary.BigEndian.PutUint64(hash[:8], difficulty-1)
+	for i := 8; i < len(hash); i++ {
+		hash[i] = 0xFF
+	}
+	if !Meets(hash, target) {
+		t.Fatal("Meets rejected a hash the legacy uint64 comparison would have accepted")
+	}
+
+	// A hash whose leading 8 bytes equal difficulty failed the old
+	// comparison regardless of its remaining bytes; it must still fail.
+	binary.BigEndian.PutUint64(hash[:8], difficulty)
+	for i := 8; i < len(hash); i++ {
+		hash[i] = 0x00
+	}
+	if Meets(hash, target) {
+		t.Fatal("Meets accepted a hash the legacy uint64 comparison would have rejected")
+	}
+}
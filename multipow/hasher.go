@@ -0,0 +1,72 @@
+package multipow
+
+import (
+	"crypto/sha256"
+	"crypto/sha512"
+
+	"golang.org/x/crypto/blake2b"
+	"lukechampine.com/blake3"
+)
+
+// Hasher computes the 32-byte digest used as a proof-of-work hash. Swapping
+// Hasher implementations lets callers check whether the k-solution variance
+// reduction this package demonstrates holds across different hash
+// primitives, the same separation between block-identity hash and PoW hash
+// used by projects like decred.
+type Hasher interface {
+	// Sum returns the 32-byte digest of data.
+	Sum(data []byte) [32]byte
+	// Name identifies the hash function, e.g. for logging or CLI flags.
+	Name() string
+}
+
+type sha256Hasher struct{}
+
+func (sha256Hasher) Sum(data []byte) [32]byte { return sha256.Sum256(data) }
+func (sha256Hasher) Name() string             { return "sha256" }
+
+type sha512_256Hasher struct{}
+
+func (sha512_256Hasher) Sum(data []byte) [32]byte { return sha512.Sum512_256(data) }
+func (sha512_256Hasher) Name() string             { return "sha512-256" }
+
+type blake2b256Hasher struct{}
+
+func (blake2b256Hasher) Sum(data []byte) [32]byte { return blake2b.Sum256(data) }
+func (blake2b256Hasher) Name() string             { return "blake2b-256" }
+
+type blake3Hasher struct{}
+
+func (blake3Hasher) Sum(data []byte) [32]byte { return blake3.Sum256(data) }
+func (blake3Hasher) Name() string             { return "blake3" }
+
+// Hashers holds the built-in Hasher implementations, keyed by Name(), so
+// callers can select one from a flag or config value.
+var Hashers = map[string]Hasher{
+	"sha256":      sha256Hasher{},
+	"sha512-256":  sha512_256Hasher{},
+	"blake2b-256": blake2b256Hasher{},
+	"blake3":      blake3Hasher{},
+}
+
+// Option configures optional behavior of VerifyTarget, SolveParallel,
+// SolveIndependent, and VerifyIndependent.
+type Option func(*options)
+
+type options struct {
+	hasher Hasher
+}
+
+func newOptions(opts []Option) options {
+	o := options{hasher: sha256Hasher{}}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// WithHasher selects the Hasher used to compute candidate digests, in place
+// of the default SHA-256.
+func WithHasher(h Hasher) Option {
+	return func(o *options) { o.hasher = h }
+}
@@ -0,0 +1,51 @@
+package multipow
+
+import (
+	"context"
+	"encoding/binary"
+	"testing"
+)
+
+func TestHashersRegistry(t *testing.T) {
+	for _, name := range []string{"sha256", "sha512-256", "blake2b-256", "blake3"} {
+		h, ok := Hashers[name]
+		if !ok {
+			t.Fatalf("Hashers[%q] missing", name)
+		}
+		if h.Name() != name {
+			t.Fatalf("Hashers[%q].Name() = %q, want %q", name, h.Name(), name)
+		}
+	}
+}
+
+func TestMineAndVerifyWithAlternateHasher(t *testing.T) {
+	data := []byte("hashproof")
+	difficulty := uint64(0x0FFFFFFFFFFFFFFF)
+
+	for name, hasher := range Hashers {
+		t.Run(name, func(t *testing.T) {
+			m := &Miner{Data: data, Difficulty: difficulty, NumSolutions: 1, Hasher: hasher}
+			nonces, err := m.Mine(context.Background())
+			if err != nil {
+				t.Fatalf("Mine returned error: %v", err)
+			}
+
+			if !VerifyTarget(data, nonces, m.target(), 1, WithHasher(hasher)) {
+				t.Fatalf("VerifyTarget rejected a solution mined with %s", name)
+			}
+
+			if hasher.Name() != "sha256" {
+				// Digests from distinct hash functions over the same input
+				// diverge for all practical purposes, so this is a
+				// deterministic check rather than a probabilistic one tied
+				// to how large difficulty happens to be.
+				var buff [40]byte
+				binary.BigEndian.PutUint64(buff[:8], nonces[0])
+				copy(buff[8:], data)
+				if hasher.Sum(buff[:]) == (sha256Hasher{}).Sum(buff[:]) {
+					t.Fatalf("%s produced the same digest as sha256 for the same input", name)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package multipow
+
+import "testing"
+
+func TestSolveAndVerifyIndependent(t *testing.T) {
+	data := []byte("hashproof")
+	difficulty := uint64(0x0FFFFFFFFFFFFFFF)
+	k := 4
+
+	solutions := SolveIndependent(data, difficulty, k)
+	if len(solutions) != k {
+		t.Fatalf("got %d solutions, want %d", len(solutions), k)
+	}
+	for i, s := range solutions {
+		if s.Index != i {
+			t.Fatalf("solutions[%d].Index = %d, want %d", i, s.Index, i)
+		}
+	}
+	if !VerifyIndependent(data, solutions, difficulty, k) {
+		t.Fatalf("VerifyIndependent rejected a solution produced by SolveIndependent: %v", solutions)
+	}
+}
+
+func TestVerifyIndependentRejectsBadSolutions(t *testing.T) {
+	data := []byte("hashproof")
+	difficulty := uint64(0x0FFFFFFFFFFFFFFF)
+	k := 3
+
+	solutions := SolveIndependent(data, difficulty, k)
+
+	if VerifyIndependent(data, solutions, difficulty, k+1) {
+		t.Fatal("VerifyIndependent accepted the wrong k")
+	}
+
+	duplicateIndex := append([]Solution(nil), solutions...)
+	duplicateIndex[1].Index = duplicateIndex[0].Index
+	if VerifyIndependent(data, duplicateIndex, difficulty, k) {
+		t.Fatal("VerifyIndependent accepted solutions with a duplicate index")
+	}
+
+	wrongNonce := append([]Solution(nil), solutions...)
+	wrongNonce[0].Nonce++
+	if VerifyIndependent(data, wrongNonce, difficulty, k) {
+		t.Fatal("VerifyIndependent accepted a tampered nonce")
+	}
+
+	outOfRange := append([]Solution(nil), solutions...)
+	outOfRange[0].Index = k
+	if VerifyIndependent(data, outOfRange, difficulty, k) {
+		t.Fatal("VerifyIndependent accepted an out-of-range index")
+	}
+}
+
+func TestSolveIndependentIsolatesSubPuzzles(t *testing.T) {
+	// A solution for index 0 should not satisfy index 1's sub-puzzle, since
+	// the index is bound into the pre-image.
+	data := []byte("hashproof")
+	difficulty := uint64(0x0FFFFFFFFFFFFFFF)
+
+	solutions := SolveIndependent(data, difficulty, 2)
+	swapped := []Solution{{Index: 1, Nonce: solutions[0].Nonce}, {Index: 0, Nonce: solutions[1].Nonce}}
+	if VerifyIndependent(data, swapped, difficulty, 2) {
+		t.Fatal("VerifyIndependent accepted a nonce solved for a different index")
+	}
+}
@@ -0,0 +1,138 @@
+// Package multipow implements the "multiple-solutions" proof-of-work scheme
+// demonstrated in this repository: instead of accepting the first nonce
+// that solves a hash puzzle, a prover must find NumSolutions distinct
+// qualifying nonces for the same data before a solution is accepted.
+//
+// Solution times for a single PoW are distributed exponentially, so the gap
+// between the fastest and slowest provers in a population can be enormous.
+// Requiring several solutions narrows that gap, because the sum of k
+// independent exponential arrival times converges toward its mean as k
+// grows (see the stats subpackage for the underlying Erlang-k analysis).
+package multipow
+
+import (
+	"context"
+	"encoding/binary"
+	"math/big"
+	"time"
+)
+
+// Miner searches for nonces that solve a multi-solution proof of work over
+// Data. Analogous to the PoW/Verify split used in Ethash-derived miners,
+// a Miner only knows how to search; Verify below is the stateless check
+// anyone can run against a claimed solution.
+type Miner struct {
+	Data         []byte   // the data being proved; combined with each candidate nonce
+	Difficulty   uint64   // deprecated: a hash qualifies when its leading 8 bytes, read as a uint64, are below this value. Ignored if Target is set.
+	Target       *big.Int // a hash qualifies when HashToBig(hash) is below Target. Takes precedence over Difficulty.
+	NumSolutions int      // number of distinct qualifying nonces required
+	Hasher       Hasher   // hash function used for candidate digests; defaults to SHA-256 if nil
+
+	hashes  int64
+	elapsed time.Duration
+}
+
+// target returns the full 256-bit target Mine should search against,
+// falling back to the legacy uint64 Difficulty field when Target is unset.
+func (m *Miner) target() *big.Int {
+	if m.Target != nil {
+		return m.Target
+	}
+	return LegacyDifficultyToTarget(m.Difficulty)
+}
+
+// hasher returns the Hasher Mine should use, defaulting to SHA-256.
+func (m *Miner) hasher() Hasher {
+	if m.Hasher != nil {
+		return m.Hasher
+	}
+	return sha256Hasher{}
+}
+
+// Mine walks nonces upward from zero looking for NumSolutions hashes of
+// (Data, nonce) that satisfy the Miner's target. It returns the qualifying
+// nonces in the order they were found. If ctx is cancelled before
+// NumSolutions nonces are found, Mine returns ctx.Err() and whatever nonces
+// were found so far.
+func (m *Miner) Mine(ctx context.Context) ([]uint64, error) {
+	var buff [40]byte
+	copy(buff[8:], m.Data)
+	target := m.target()
+	hasher := m.hasher()
+
+	var nonces []uint64
+	var nonce uint64
+	start := time.Now()
+	m.hashes = 0
+
+	for {
+		if nonce%1024 == 0 { // avoid paying ctx.Done() overhead on every hash
+			select {
+			case <-ctx.Done():
+				m.elapsed = time.Since(start)
+				return nonces, ctx.Err()
+			default:
+			}
+		}
+
+		binary.BigEndian.PutUint64(buff[:], nonce)
+		v := hasher.Sum(buff[:])
+		m.hashes++
+		if Meets(v, target) {
+			nonces = append(nonces, nonce)
+			if len(nonces) >= m.NumSolutions {
+				m.elapsed = time.Since(start)
+				return nonces, nil
+			}
+		}
+		nonce++
+	}
+}
+
+// HashRate returns the number of hashes computed per second during the most
+// recent call to Mine. It is zero until Mine has returned at least once.
+func (m *Miner) HashRate() int64 {
+	if m.elapsed <= 0 {
+		return 0
+	}
+	return int64(float64(m.hashes) / m.elapsed.Seconds())
+}
+
+// Verify reports whether nonces are numSolutions distinct nonces that each
+// satisfy difficulty against data. It is the counterpart to Miner.Mine and
+// requires no state of its own.
+//
+// Deprecated: difficulty is interpreted against only the top 8 bytes of the
+// hash. Use VerifyTarget with a full 256-bit target instead.
+func Verify(data []byte, nonces []uint64, difficulty uint64, numSolutions int) bool {
+	return VerifyTarget(data, nonces, LegacyDifficultyToTarget(difficulty), numSolutions)
+}
+
+// VerifyTarget reports whether nonces are numSolutions distinct nonces that
+// each satisfy target against data, comparing the full 256-bit hash rather
+// than just its leading bytes. By default it hashes with SHA-256; pass
+// WithHasher to match a Miner configured with a different Hasher.
+func VerifyTarget(data []byte, nonces []uint64, target *big.Int, numSolutions int, opts ...Option) bool {
+	if len(nonces) != numSolutions {
+		return false
+	}
+	o := newOptions(opts)
+
+	var buff [40]byte
+	copy(buff[8:], data)
+
+	seen := make(map[uint64]bool, len(nonces))
+	for _, nonce := range nonces {
+		if seen[nonce] {
+			return false // nonces must be distinct
+		}
+		seen[nonce] = true
+
+		binary.BigEndian.PutUint64(buff[:], nonce)
+		v := o.hasher.Sum(buff[:])
+		if !Meets(v, target) {
+			return false
+		}
+	}
+	return true
+}
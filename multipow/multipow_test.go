@@ -0,0 +1,78 @@
+package multipow
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestMineAndVerify(t *testing.T) {
+	cases := []struct {
+		name         string
+		data         []byte
+		difficulty   uint64
+		numSolutions int
+	}{
+		{"single solution, easy target", []byte{1, 2, 3}, 0x0FFFFFFFFFFFFFFF, 1},
+		{"three solutions, easy target", []byte("hashproof"), 0x00FFFFFFFFFFFFFF, 3},
+		{"single solution, different data", []byte{9, 9, 9, 9}, 0x0FFFFFFFFFFFFFFF, 1},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			m := &Miner{Data: c.data, Difficulty: c.difficulty, NumSolutions: c.numSolutions}
+
+			nonces, err := m.Mine(context.Background())
+			if err != nil {
+				t.Fatalf("Mine returned error: %v", err)
+			}
+			if len(nonces) != c.numSolutions {
+				t.Fatalf("got %d nonces, want %d", len(nonces), c.numSolutions)
+			}
+			if !Verify(c.data, nonces, c.difficulty, c.numSolutions) {
+				t.Fatalf("Verify rejected a solution produced by Mine: %v", nonces)
+			}
+			if m.HashRate() <= 0 {
+				t.Fatalf("HashRate() = %d, want > 0 after Mine completed", m.HashRate())
+			}
+		})
+	}
+}
+
+func TestVerifyRejectsBadSolutions(t *testing.T) {
+	data := []byte("hashproof")
+	difficulty := uint64(0x00FFFFFFFFFFFFFF)
+
+	m := &Miner{Data: data, Difficulty: difficulty, NumSolutions: 2}
+	nonces, err := m.Mine(context.Background())
+	if err != nil {
+		t.Fatalf("Mine returned error: %v", err)
+	}
+
+	if Verify(data, nonces, difficulty, 3) {
+		t.Fatal("Verify accepted a solution with the wrong numSolutions")
+	}
+	if Verify(data, nonces[:1], difficulty, 1) == false {
+		t.Fatalf("Verify rejected a valid single-nonce prefix: %v", nonces[:1])
+	}
+	if Verify(data, []uint64{nonces[0], nonces[0]}, difficulty, 2) {
+		t.Fatal("Verify accepted duplicate nonces")
+	}
+	if Verify([]byte("different data"), nonces, difficulty, 2) {
+		t.Fatal("Verify accepted a solution against different data")
+	}
+}
+
+func TestMineRespectsCancellation(t *testing.T) {
+	// An effectively impossible target means Mine will run until ctx is
+	// cancelled rather than finding NumSolutions nonces.
+	m := &Miner{Data: []byte("hashproof"), Difficulty: 1, NumSolutions: 1}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err := m.Mine(ctx)
+	if err != context.DeadlineExceeded {
+		t.Fatalf("Mine returned %v, want context.DeadlineExceeded", err)
+	}
+}
@@ -0,0 +1,75 @@
+package multipow
+
+import (
+	"context"
+	"encoding/binary"
+	"sort"
+	"sync"
+)
+
+// SolveParallel finds numSolutions nonces satisfying difficulty against
+// data, splitting the 64-bit nonce space into workers disjoint stripes:
+// worker k searches nonces k, k+workers, k+2*workers, and so on. The first
+// numSolutions qualifying nonces reported by any worker are accepted and
+// the remaining workers are stopped.
+//
+// The returned nonces are sorted ascending, so Verify remains reproducible
+// regardless of which worker happened to find which nonce. By default it
+// hashes with SHA-256; pass WithHasher to use a different Hasher.
+func SolveParallel(data []byte, difficulty uint64, numSolutions int, workers int, opts ...Option) []uint64 {
+	if workers < 1 {
+		workers = 1
+	}
+	o := newOptions(opts)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	found := make(chan uint64, workers)
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for k := 0; k < workers; k++ {
+		go func(start uint64) {
+			defer wg.Done()
+			searchStripe(ctx, data, difficulty, start, uint64(workers), o.hasher, found)
+		}(uint64(k))
+	}
+
+	nonces := make([]uint64, 0, numSolutions)
+	for len(nonces) < numSolutions {
+		nonces = append(nonces, <-found)
+	}
+	cancel()  // enough solutions found; stop the remaining workers
+	wg.Wait() // wait for every worker to notice cancellation before returning
+
+	sort.Slice(nonces, func(i, j int) bool { return nonces[i] < nonces[j] })
+	return nonces
+}
+
+// searchStripe walks nonces starting at nonce and advancing by step,
+// reporting any that satisfy difficulty under hasher on found. It returns
+// once ctx is cancelled.
+func searchStripe(ctx context.Context, data []byte, difficulty, nonce, step uint64, hasher Hasher, found chan<- uint64) {
+	var buff [40]byte
+	copy(buff[8:], data)
+
+	for i := 0; ; i++ {
+		if i%1024 == 0 { // avoid paying ctx.Done() overhead on every hash
+			select {
+			case <-ctx.Done():
+				return
+			default:
+			}
+		}
+
+		binary.BigEndian.PutUint64(buff[:], nonce)
+		if legacyMeets(hasher.Sum(buff[:]), difficulty) {
+			select {
+			case found <- nonce:
+			case <-ctx.Done():
+				return
+			}
+		}
+		nonce += step
+	}
+}
@@ -0,0 +1,60 @@
+package multipow
+
+import (
+	"testing"
+)
+
+func TestSolveParallel(t *testing.T) {
+	cases := []struct {
+		name         string
+		difficulty   uint64
+		numSolutions int
+		workers      int
+	}{
+		{"single worker behaves like a sequential search", 0x0FFFFFFFFFFFFFFF, 1, 1},
+		{"four workers, several solutions", 0x00FFFFFFFFFFFFFF, 5, 4},
+		{"more workers than solutions needed", 0x0FFFFFFFFFFFFFFF, 1, 8},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			data := []byte("hashproof")
+
+			nonces := SolveParallel(data, c.difficulty, c.numSolutions, c.workers)
+			if len(nonces) != c.numSolutions {
+				t.Fatalf("got %d nonces, want %d", len(nonces), c.numSolutions)
+			}
+			for i := 1; i < len(nonces); i++ {
+				if nonces[i-1] >= nonces[i] {
+					t.Fatalf("nonces not strictly ascending: %v", nonces)
+				}
+			}
+			if !Verify(data, nonces, c.difficulty, c.numSolutions) {
+				t.Fatalf("Verify rejected a solution produced by SolveParallel: %v", nonces)
+			}
+		})
+	}
+}
+
+func TestSolveParallelDefaultsToOneWorker(t *testing.T) {
+	data := []byte("hashproof")
+	nonces := SolveParallel(data, 0x0FFFFFFFFFFFFFFF, 1, 0)
+	if len(nonces) != 1 {
+		t.Fatalf("got %d nonces, want 1", len(nonces))
+	}
+}
+
+func benchmarkSolveParallel(b *testing.B, workers int) {
+	data := []byte("hashproof")
+	difficulty := uint64(0x001FFFFFFFFFFFFF)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		SolveParallel(data, difficulty, 8, workers)
+	}
+}
+
+func BenchmarkSolveParallel1Worker(b *testing.B)  { benchmarkSolveParallel(b, 1) }
+func BenchmarkSolveParallel2Workers(b *testing.B) { benchmarkSolveParallel(b, 2) }
+func BenchmarkSolveParallel4Workers(b *testing.B) { benchmarkSolveParallel(b, 4) }
+func BenchmarkSolveParallel8Workers(b *testing.B) { benchmarkSolveParallel(b, 8) }
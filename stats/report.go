@@ -0,0 +1,63 @@
+package stats
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// WriteTable writes summaries as the human-readable table this package's
+// callers print by default, with empirical and theoretical CV side by side
+// so the Erlang-k convergence claim can be read off directly.
+func WriteTable(w io.Writer, summaries []Summary) error {
+	_, err := fmt.Fprintf(w, "%11s %10s %15s %15s %15s %10s %10s\n",
+		"k", "samples", "mean(ns)", "stddev(ns)", "median(ns)", "cv", "cv(theory)")
+	if err != nil {
+		return err
+	}
+	for _, s := range summaries {
+		if _, err := fmt.Fprintf(w, "%11d %10d %15d %15d %15d %10.4f %10.4f\n",
+			s.K, s.Samples, s.Mean, s.StdDev, s.Median, s.CV, s.TheoreticalCV); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WriteCSV writes summaries as CSV, one row per Summary, suitable for
+// plotting the convergence of variance as k grows.
+func WriteCSV(w io.Writer, summaries []Summary) error {
+	cw := csv.NewWriter(w)
+	header := []string{"k", "samples", "mean_ns", "median_ns", "std_dev_ns", "p1_ns", "p50_ns", "p99_ns", "cv", "theoretical_cv"}
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+	for _, s := range summaries {
+		row := []string{
+			strconv.Itoa(s.K),
+			strconv.Itoa(s.Samples),
+			strconv.FormatInt(int64(s.Mean), 10),
+			strconv.FormatInt(int64(s.Median), 10),
+			strconv.FormatInt(int64(s.StdDev), 10),
+			strconv.FormatInt(int64(s.P1), 10),
+			strconv.FormatInt(int64(s.P50), 10),
+			strconv.FormatInt(int64(s.P99), 10),
+			strconv.FormatFloat(s.CV, 'f', -1, 64),
+			strconv.FormatFloat(s.TheoreticalCV, 'f', -1, 64),
+		}
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// WriteJSON writes summaries as a JSON array.
+func WriteJSON(w io.Writer, summaries []Summary) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(summaries)
+}
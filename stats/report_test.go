@@ -0,0 +1,50 @@
+package stats
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+)
+
+func testSummaries() []Summary {
+	return []Summary{
+		Summarize(1, []time.Duration{10, 20, 30}),
+		Summarize(4, []time.Duration{8, 9, 10}),
+	}
+}
+
+func TestWriteCSVHasHeaderAndOneRowPerSummary(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteCSV(&buf, testSummaries()); err != nil {
+		t.Fatalf("WriteCSV returned error: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimRight(buf.String(), "\n"), "\n")
+	if len(lines) != 3 { // header + 2 summaries
+		t.Fatalf("got %d lines, want 3:\n%s", len(lines), buf.String())
+	}
+	if !strings.HasPrefix(lines[0], "k,samples,") {
+		t.Fatalf("unexpected CSV header: %q", lines[0])
+	}
+}
+
+func TestWriteJSONProducesValidArray(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteJSON(&buf, testSummaries()); err != nil {
+		t.Fatalf("WriteJSON returned error: %v", err)
+	}
+	if !strings.HasPrefix(strings.TrimSpace(buf.String()), "[") {
+		t.Fatalf("expected a JSON array, got: %s", buf.String())
+	}
+}
+
+func TestWriteTableIncludesCVColumns(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteTable(&buf, testSummaries()); err != nil {
+		t.Fatalf("WriteTable returned error: %v", err)
+	}
+	if !strings.Contains(buf.String(), "cv(theory)") {
+		t.Fatalf("expected table header to mention cv(theory), got: %s", buf.String())
+	}
+}
@@ -0,0 +1,99 @@
+// Package stats analyzes the solution-time distribution of the
+// multipow "k-of-N" scheme: requiring k independent proof-of-work
+// solutions instead of one.
+//
+// A single PoW's solution time is distributed Exp(λ). Requiring k
+// independent solutions for the same difficulty sums k of those arrivals,
+// which is Gamma(k, 1/λ) (equivalently Erlang-k): mean k/λ, variance k/λ²,
+// and coefficient of variation 1/√k. This package computes the empirical
+// equivalents from measured durations so that convergence can be checked
+// against the theoretical prediction as k grows.
+package stats
+
+import (
+	"math"
+	"sort"
+	"time"
+)
+
+// Summary reports empirical and theoretical statistics for the durations
+// observed while requiring K solutions.
+type Summary struct {
+	K       int `json:"k"`
+	Samples int `json:"samples"`
+
+	Mean   time.Duration `json:"mean_ns"`
+	Median time.Duration `json:"median_ns"`
+	StdDev time.Duration `json:"std_dev_ns"`
+	P1     time.Duration `json:"p1_ns"`
+	P50    time.Duration `json:"p50_ns"`
+	P99    time.Duration `json:"p99_ns"`
+
+	// CV is the empirical coefficient of variation (StdDev / Mean).
+	CV float64 `json:"cv"`
+	// TheoreticalCV is 1/sqrt(K), the coefficient of variation predicted
+	// by the Erlang-k model for K independent exponential solutions.
+	TheoreticalCV float64 `json:"theoretical_cv"`
+}
+
+// Summarize computes a Summary over durations, the observed wall-clock
+// times to find k qualifying solutions. It does not modify durations. If
+// durations is empty, Summarize returns a zero-valued Summary except for K
+// and TheoreticalCV, which don't depend on any samples.
+func Summarize(k int, durations []time.Duration) Summary {
+	n := len(durations)
+	if n == 0 {
+		return Summary{K: k, TheoreticalCV: 1 / math.Sqrt(float64(k))}
+	}
+
+	sorted := append([]time.Duration(nil), durations...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	var sum time.Duration
+	for _, d := range sorted {
+		sum += d
+	}
+	mean := sum / time.Duration(n)
+
+	meanF := float64(mean)
+	var sumSquaredDiff float64
+	for _, d := range sorted {
+		diff := float64(d) - meanF
+		sumSquaredDiff += diff * diff
+	}
+	stddev := time.Duration(math.Sqrt(sumSquaredDiff / float64(n)))
+
+	var cv float64
+	if meanF != 0 {
+		cv = float64(stddev) / meanF
+	}
+
+	return Summary{
+		K:             k,
+		Samples:       n,
+		Mean:          mean,
+		Median:        percentile(sorted, 50),
+		StdDev:        stddev,
+		P1:            percentile(sorted, 1),
+		P50:           percentile(sorted, 50),
+		P99:           percentile(sorted, 99),
+		CV:            cv,
+		TheoreticalCV: 1 / math.Sqrt(float64(k)),
+	}
+}
+
+// percentile returns the duration at percentile p (0-100) of sorted, which
+// must already be sorted ascending. It uses nearest-rank interpolation.
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	idx := int(p/100*float64(len(sorted)-1) + 0.5)
+	if idx < 0 {
+		idx = 0
+	}
+	if idx >= len(sorted) {
+		idx = len(sorted) - 1
+	}
+	return sorted[idx]
+}
@@ -0,0 +1,70 @@
+package stats
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestSummarizeBasicStats(t *testing.T) {
+	durations := []time.Duration{
+		10 * time.Millisecond,
+		20 * time.Millisecond,
+		30 * time.Millisecond,
+		40 * time.Millisecond,
+	}
+
+	s := Summarize(1, durations)
+
+	if s.Samples != len(durations) {
+		t.Fatalf("Samples = %d, want %d", s.Samples, len(durations))
+	}
+	if want := 25 * time.Millisecond; s.Mean != want {
+		t.Fatalf("Mean = %s, want %s", s.Mean, want)
+	}
+	if s.P1 != durations[0] {
+		t.Fatalf("P1 = %s, want the minimum %s", s.P1, durations[0])
+	}
+	if s.P99 != durations[len(durations)-1] {
+		t.Fatalf("P99 = %s, want the maximum %s", s.P99, durations[len(durations)-1])
+	}
+}
+
+func TestSummarizeTheoreticalCV(t *testing.T) {
+	durations := []time.Duration{time.Second, 2 * time.Second, 3 * time.Second}
+
+	for _, k := range []int{1, 4, 16} {
+		s := Summarize(k, durations)
+		want := 1 / math.Sqrt(float64(k))
+		if s.TheoreticalCV != want {
+			t.Fatalf("k=%d: TheoreticalCV = %v, want %v", k, s.TheoreticalCV, want)
+		}
+	}
+}
+
+func TestSummarizeEmptyDurationsDoesNotPanic(t *testing.T) {
+	s := Summarize(4, nil)
+	if s.Samples != 0 {
+		t.Fatalf("Samples = %d, want 0", s.Samples)
+	}
+	if s.Mean != 0 || s.StdDev != 0 || s.CV != 0 {
+		t.Fatalf("expected zero-valued statistics for an empty input, got %+v", s)
+	}
+	if want := 1 / math.Sqrt(4); s.TheoreticalCV != want {
+		t.Fatalf("TheoreticalCV = %v, want %v", s.TheoreticalCV, want)
+	}
+}
+
+func TestPercentileOnSortedInput(t *testing.T) {
+	sorted := []time.Duration{1, 2, 3, 4, 5}
+
+	if got := percentile(sorted, 0); got != 1 {
+		t.Fatalf("percentile(0) = %d, want 1", got)
+	}
+	if got := percentile(sorted, 100); got != 5 {
+		t.Fatalf("percentile(100) = %d, want 5", got)
+	}
+	if got := percentile(nil, 50); got != 0 {
+		t.Fatalf("percentile of empty input = %d, want 0", got)
+	}
+}